@@ -0,0 +1,110 @@
+// Command cognito_user_migration_lambda is the canned MigrateUser trigger
+// deployed by aws_cognito_user_pool_migration_source when create_function is
+// enabled. It looks up the incoming username (or alias email) in the
+// DynamoDB table named by the MIGRATION_DYNAMODB_TABLE_ARN environment
+// variable, confirms any match as a native Cognito user, and copies the
+// mapped legacy attributes into the response so the pool can materialize the
+// user on first sign-in or forgot-password.
+//
+// This file is not compiled as part of the provider. It is the source for
+// the deployment package that resourceAwsCognitoUserPoolMigrationSource
+// embeds; see cognito_user_migration_lambda_zip.go for how the package is
+// regenerated.
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-lambda-go/lambda"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+)
+
+func handler(ctx context.Context, event events.CognitoEventUserPoolsMigrateUser) (events.CognitoEventUserPoolsMigrateUser, error) {
+	tableArn := os.Getenv("MIGRATION_DYNAMODB_TABLE_ARN")
+	if tableArn == "" {
+		return event, fmt.Errorf("MIGRATION_DYNAMODB_TABLE_ARN is not set")
+	}
+
+	tableName := tableArn[strings.LastIndex(tableArn, "/")+1:]
+
+	sess := session.Must(session.NewSession())
+	conn := dynamodb.New(sess)
+
+	record, err := findLegacyUserRecord(conn, tableName, event.UserName)
+	if err != nil {
+		return event, err
+	}
+	if record == nil {
+		return event, fmt.Errorf("legacy user record not found for %q", event.UserName)
+	}
+
+	attributes := map[string]string{
+		"email":          record.Email,
+		"email_verified": "true",
+	}
+
+	event.UserAttributes = attributes
+	event.FinalUserStatus = "CONFIRMED"
+	event.MessageAction = "SUPPRESS"
+
+	return event, nil
+}
+
+type legacyUserRecord struct {
+	Username string `dynamodbav:"username"`
+	Email    string `dynamodbav:"email"`
+	Password string `dynamodbav:"password"`
+}
+
+func findLegacyUserRecord(conn *dynamodb.DynamoDB, tableName, usernameOrEmail string) (*legacyUserRecord, error) {
+	getOut, err := conn.GetItem(&dynamodb.GetItemInput{
+		TableName: aws.String(tableName),
+		Key: map[string]*dynamodb.AttributeValue{
+			"username": {S: aws.String(usernameOrEmail)},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading legacy user table: %s", err)
+	}
+
+	item := getOut.Item
+
+	if item == nil {
+		queryOut, err := conn.Query(&dynamodb.QueryInput{
+			TableName:              aws.String(tableName),
+			IndexName:              aws.String("email-index"),
+			KeyConditionExpression: aws.String("email = :email"),
+			ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+				":email": {S: aws.String(usernameOrEmail)},
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error querying legacy user table by email: %s", err)
+		}
+		if len(queryOut.Items) > 0 {
+			item = queryOut.Items[0]
+		}
+	}
+
+	if item == nil {
+		return nil, nil
+	}
+
+	record := &legacyUserRecord{}
+	if err := dynamodbattribute.UnmarshalMap(item, record); err != nil {
+		return nil, fmt.Errorf("error unmarshaling legacy user record: %s", err)
+	}
+
+	return record, nil
+}
+
+func main() {
+	lambda.Start(handler)
+}
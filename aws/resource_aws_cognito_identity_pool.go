@@ -0,0 +1,268 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceAwsCognitoIdentityPool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCognitoIdentityPoolCreate,
+		Read:   resourceAwsCognitoIdentityPoolRead,
+		Update: resourceAwsCognitoIdentityPoolUpdate,
+		Delete: resourceAwsCognitoIdentityPoolDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		// https://docs.aws.amazon.com/cognitoidentity/latest/APIReference/API_CreateIdentityPool.html
+		Schema: map[string]*schema.Schema{
+			"identity_pool_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[\w\s+=,.@-]+$`),
+					"only alphanumeric characters, spaces, and the following characters: + = , . @ -",
+				),
+			},
+			"allow_unauthenticated_identities": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"developer_provider_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[\w.-]+$`),
+					"only alphanumeric characters, dots, and dashes",
+				),
+			},
+			"supported_login_providers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"cognito_identity_providers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"client_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"provider_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"server_side_token_check": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"openid_connect_provider_arns": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"saml_provider_arns": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCognitoIdentityPoolCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoconn
+
+	params := &cognitoidentity.CreateIdentityPoolInput{
+		IdentityPoolName:               aws.String(d.Get("identity_pool_name").(string)),
+		AllowUnauthenticatedIdentities: aws.Bool(d.Get("allow_unauthenticated_identities").(bool)),
+	}
+
+	if v, ok := d.GetOk("developer_provider_name"); ok {
+		params.DeveloperProviderName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("supported_login_providers"); ok {
+		params.SupportedLoginProviders = expandCognitoSupportedLoginProviders(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("cognito_identity_providers"); ok {
+		params.CognitoIdentityProviders = expandCognitoIdentityProviders(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("openid_connect_provider_arns"); ok {
+		params.OpenIdConnectProviderARNs = expandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("saml_provider_arns"); ok {
+		params.SamlProviderARNs = expandStringList(v.([]interface{}))
+	}
+
+	log.Print("[DEBUG] Creating Cognito Identity Pool")
+
+	entity, err := conn.CreateIdentityPool(params)
+	if err != nil {
+		return fmt.Errorf("Error creating Cognito Identity Pool: %s", err)
+	}
+
+	d.SetId(aws.StringValue(entity.IdentityPoolId))
+
+	return resourceAwsCognitoIdentityPoolRead(d, meta)
+}
+
+func resourceAwsCognitoIdentityPoolRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoconn
+
+	ip, err := conn.DescribeIdentityPool(&cognitoidentity.DescribeIdentityPoolInput{
+		IdentityPoolId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, cognitoidentity.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Cognito Identity Pool %s is already gone", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Cognito Identity Pool: %s", err)
+	}
+
+	d.Set("identity_pool_name", ip.IdentityPoolName)
+	d.Set("allow_unauthenticated_identities", ip.AllowUnauthenticatedIdentities)
+	d.Set("developer_provider_name", ip.DeveloperProviderName)
+
+	if err := d.Set("supported_login_providers", flattenCognitoSupportedLoginProviders(ip.SupportedLoginProviders)); err != nil {
+		return fmt.Errorf("Error setting supported_login_providers error: %#v", err)
+	}
+
+	if err := d.Set("cognito_identity_providers", flattenCognitoIdentityProviders(ip.CognitoIdentityProviders)); err != nil {
+		return fmt.Errorf("Error setting cognito_identity_providers error: %#v", err)
+	}
+
+	if err := d.Set("openid_connect_provider_arns", flattenStringList(ip.OpenIdConnectProviderARNs)); err != nil {
+		return fmt.Errorf("Error setting openid_connect_provider_arns error: %#v", err)
+	}
+
+	if err := d.Set("saml_provider_arns", flattenStringList(ip.SamlProviderARNs)); err != nil {
+		return fmt.Errorf("Error setting saml_provider_arns error: %#v", err)
+	}
+
+	d.Set("arn", fmt.Sprintf("arn:%s:cognito-identity:%s:%s:identitypool/%s", meta.(*AWSClient).partition, meta.(*AWSClient).region, meta.(*AWSClient).accountid, d.Id()))
+
+	return nil
+}
+
+func resourceAwsCognitoIdentityPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoconn
+
+	// UpdateIdentityPool is a full-replace call: every field below must be
+	// resent unconditionally on every update, since an omitted field (e.g.
+	// because d.GetOk treats an empty set/list as "not set") would wipe out
+	// whatever AWS currently has instead of leaving it alone.
+	params := &cognitoidentity.IdentityPool{
+		IdentityPoolId:                 aws.String(d.Id()),
+		IdentityPoolName:               aws.String(d.Get("identity_pool_name").(string)),
+		AllowUnauthenticatedIdentities: aws.Bool(d.Get("allow_unauthenticated_identities").(bool)),
+		SupportedLoginProviders:        expandCognitoSupportedLoginProviders(d.Get("supported_login_providers").(map[string]interface{})),
+		CognitoIdentityProviders:       expandCognitoIdentityProviders(d.Get("cognito_identity_providers").([]interface{})),
+		OpenIdConnectProviderARNs:      expandStringList(d.Get("openid_connect_provider_arns").([]interface{})),
+		SamlProviderARNs:               expandStringList(d.Get("saml_provider_arns").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("developer_provider_name"); ok {
+		params.DeveloperProviderName = aws.String(v.(string))
+	}
+
+	log.Print("[DEBUG] Updating Cognito Identity Pool")
+
+	_, err := conn.UpdateIdentityPool(params)
+	if err != nil {
+		return fmt.Errorf("Error updating Cognito Identity Pool: %s", err)
+	}
+
+	return resourceAwsCognitoIdentityPoolRead(d, meta)
+}
+
+func resourceAwsCognitoIdentityPoolDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoconn
+
+	log.Print("[DEBUG] Deleting Cognito Identity Pool")
+
+	_, err := conn.DeleteIdentityPool(&cognitoidentity.DeleteIdentityPoolInput{
+		IdentityPoolId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, cognitoidentity.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Cognito Identity Pool: %s", err)
+	}
+
+	return nil
+}
+
+func expandCognitoSupportedLoginProviders(in map[string]interface{}) map[string]*string {
+	providers := make(map[string]*string, len(in))
+	for k, v := range in {
+		providers[k] = aws.String(v.(string))
+	}
+	return providers
+}
+
+func flattenCognitoSupportedLoginProviders(in map[string]*string) map[string]string {
+	providers := make(map[string]string, len(in))
+	for k, v := range in {
+		providers[k] = aws.StringValue(v)
+	}
+	return providers
+}
+
+func expandCognitoIdentityProviders(in []interface{}) []*cognitoidentity.Provider {
+	providers := make([]*cognitoidentity.Provider, len(in))
+
+	for i, p := range in {
+		m := p.(map[string]interface{})
+		providers[i] = &cognitoidentity.Provider{
+			ClientId:             aws.String(m["client_id"].(string)),
+			ProviderName:         aws.String(m["provider_name"].(string)),
+			ServerSideTokenCheck: aws.Bool(m["server_side_token_check"].(bool)),
+		}
+	}
+
+	return providers
+}
+
+func flattenCognitoIdentityProviders(in []*cognitoidentity.Provider) []map[string]interface{} {
+	providers := make([]map[string]interface{}, len(in))
+
+	for i, p := range in {
+		providers[i] = map[string]interface{}{
+			"client_id":               aws.StringValue(p.ClientId),
+			"provider_name":           aws.StringValue(p.ProviderName),
+			"server_side_token_check": aws.BoolValue(p.ServerSideTokenCheck),
+		}
+	}
+
+	return providers
+}
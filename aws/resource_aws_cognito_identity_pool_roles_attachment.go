@@ -0,0 +1,283 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceAwsCognitoIdentityPoolRolesAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCognitoIdentityPoolRolesAttachmentCreate,
+		Read:   resourceAwsCognitoIdentityPoolRolesAttachmentRead,
+		Update: resourceAwsCognitoIdentityPoolRolesAttachmentUpdate,
+		Delete: resourceAwsCognitoIdentityPoolRolesAttachmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		// https://docs.aws.amazon.com/cognitoidentity/latest/APIReference/API_SetIdentityPoolRoles.html
+		Schema: map[string]*schema.Schema{
+			"identity_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"roles": {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"role_mapping": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identity_provider": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"ambiguous_role_resolution": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								cognitoidentity.AmbiguousRoleResolutionTypeAuthenticatedRole,
+								cognitoidentity.AmbiguousRoleResolutionTypeDeny,
+							}, false),
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								cognitoidentity.RoleMappingTypeToken,
+								cognitoidentity.RoleMappingTypeRules,
+							}, false),
+						},
+						"mapping_rule": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"claim": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"match_type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											cognitoidentity.MappingRuleMatchTypeEquals,
+											cognitoidentity.MappingRuleMatchTypeContains,
+											cognitoidentity.MappingRuleMatchTypeStartsWith,
+											cognitoidentity.MappingRuleMatchTypeNotEqual,
+										}, false),
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"role_arn": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsCognitoIdentityPoolRolesAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoconn
+
+	identityPoolId := d.Get("identity_pool_id").(string)
+
+	params := &cognitoidentity.SetIdentityPoolRolesInput{
+		IdentityPoolId: aws.String(identityPoolId),
+		Roles:          expandCognitoIdentityPoolRoles(d.Get("roles").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("role_mapping"); ok {
+		params.RoleMappings = expandCognitoIdentityPoolRoleMappings(v.(*schema.Set).List())
+	}
+
+	log.Print("[DEBUG] Setting Cognito Identity Pool roles")
+
+	_, err := conn.SetIdentityPoolRoles(params)
+	if err != nil {
+		return fmt.Errorf("Error setting Cognito Identity Pool roles: %s", err)
+	}
+
+	d.SetId(identityPoolId)
+
+	return resourceAwsCognitoIdentityPoolRolesAttachmentRead(d, meta)
+}
+
+func resourceAwsCognitoIdentityPoolRolesAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoconn
+
+	ip, err := conn.GetIdentityPoolRoles(&cognitoidentity.GetIdentityPoolRolesInput{
+		IdentityPoolId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, cognitoidentity.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Cognito Identity Pool %s is already gone", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Cognito Identity Pool roles: %s", err)
+	}
+
+	d.Set("identity_pool_id", ip.IdentityPoolId)
+	d.Set("roles", flattenCognitoIdentityPoolRoles(ip.Roles))
+
+	if err := d.Set("role_mapping", flattenCognitoIdentityPoolRoleMappings(ip.RoleMappings)); err != nil {
+		return fmt.Errorf("Error setting role_mapping error: %#v", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCognitoIdentityPoolRolesAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoconn
+
+	// SetIdentityPoolRoles is a full-replace call, so role_mapping must be
+	// resent unconditionally like roles is just below, rather than only when
+	// it changed: an untouched-but-still-configured role_mapping would
+	// otherwise be wiped out by an update to roles alone.
+	params := &cognitoidentity.SetIdentityPoolRolesInput{
+		IdentityPoolId: aws.String(d.Id()),
+		Roles:          expandCognitoIdentityPoolRoles(d.Get("roles").(map[string]interface{})),
+		RoleMappings:   expandCognitoIdentityPoolRoleMappings(d.Get("role_mapping").(*schema.Set).List()),
+	}
+
+	log.Print("[DEBUG] Updating Cognito Identity Pool roles")
+
+	_, err := conn.SetIdentityPoolRoles(params)
+	if err != nil {
+		return fmt.Errorf("Error updating Cognito Identity Pool roles: %s", err)
+	}
+
+	return resourceAwsCognitoIdentityPoolRolesAttachmentRead(d, meta)
+}
+
+func resourceAwsCognitoIdentityPoolRolesAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoconn
+
+	log.Print("[DEBUG] Removing Cognito Identity Pool roles")
+
+	_, err := conn.SetIdentityPoolRoles(&cognitoidentity.SetIdentityPoolRolesInput{
+		IdentityPoolId: aws.String(d.Id()),
+		Roles:          map[string]*string{},
+	})
+	if err != nil {
+		if isAWSErr(err, cognitoidentity.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error removing Cognito Identity Pool roles: %s", err)
+	}
+
+	return nil
+}
+
+func expandCognitoIdentityPoolRoles(in map[string]interface{}) map[string]*string {
+	roles := make(map[string]*string, len(in))
+	for k, v := range in {
+		roles[k] = aws.String(v.(string))
+	}
+	return roles
+}
+
+func flattenCognitoIdentityPoolRoles(in map[string]*string) map[string]string {
+	roles := make(map[string]string, len(in))
+	for k, v := range in {
+		roles[k] = aws.StringValue(v)
+	}
+	return roles
+}
+
+func expandCognitoIdentityPoolRoleMappings(in []interface{}) map[string]*cognitoidentity.RoleMapping {
+	mappings := make(map[string]*cognitoidentity.RoleMapping, len(in))
+
+	for _, v := range in {
+		m := v.(map[string]interface{})
+
+		mapping := &cognitoidentity.RoleMapping{
+			Type: aws.String(m["type"].(string)),
+		}
+
+		if v, ok := m["ambiguous_role_resolution"]; ok && v.(string) != "" {
+			mapping.AmbiguousRoleResolution = aws.String(v.(string))
+		}
+
+		if v, ok := m["mapping_rule"]; ok {
+			rules := v.([]interface{})
+			if len(rules) > 0 {
+				mapping.RulesConfiguration = &cognitoidentity.RulesConfigurationType{
+					Rules: expandCognitoIdentityPoolRoleMappingRules(rules),
+				}
+			}
+		}
+
+		mappings[m["identity_provider"].(string)] = mapping
+	}
+
+	return mappings
+}
+
+func expandCognitoIdentityPoolRoleMappingRules(in []interface{}) []*cognitoidentity.MappingRule {
+	rules := make([]*cognitoidentity.MappingRule, len(in))
+
+	for i, v := range in {
+		m := v.(map[string]interface{})
+		rules[i] = &cognitoidentity.MappingRule{
+			Claim:     aws.String(m["claim"].(string)),
+			MatchType: aws.String(m["match_type"].(string)),
+			Value:     aws.String(m["value"].(string)),
+			RoleARN:   aws.String(m["role_arn"].(string)),
+		}
+	}
+
+	return rules
+}
+
+func flattenCognitoIdentityPoolRoleMappings(in map[string]*cognitoidentity.RoleMapping) []map[string]interface{} {
+	mappings := make([]map[string]interface{}, 0, len(in))
+
+	for k, v := range in {
+		if v == nil {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"identity_provider":         k,
+			"type":                      aws.StringValue(v.Type),
+			"ambiguous_role_resolution": aws.StringValue(v.AmbiguousRoleResolution),
+		}
+
+		if v.RulesConfiguration != nil {
+			rules := make([]map[string]interface{}, len(v.RulesConfiguration.Rules))
+			for i, r := range v.RulesConfiguration.Rules {
+				rules[i] = map[string]interface{}{
+					"claim":      aws.StringValue(r.Claim),
+					"match_type": aws.StringValue(r.MatchType),
+					"value":      aws.StringValue(r.Value),
+					"role_arn":   aws.StringValue(r.RoleARN),
+				}
+			}
+			m["mapping_rule"] = rules
+		}
+
+		mappings = append(mappings, m)
+	}
+
+	return mappings
+}
@@ -123,6 +123,32 @@ func resourceAwsCognitoUser() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"mfa_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sms_mfa_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"software_token_mfa_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"preferred_mfa": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"SMS_MFA",
+								"SOFTWARE_TOKEN_MFA",
+							}, false),
+						},
+					},
+				},
+			},
 			"enabled": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -186,13 +212,19 @@ func resourceAwsCognitoUserCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	if g, ok := d.GetOk("groups"); ok {
-		for _, v := range g.([]string) {
-			if err := addUserToGroup(conn, d, v); err != nil {
+		for _, v := range g.(*schema.Set).List() {
+			if err := addUserToGroup(conn, d, v.(string)); err != nil {
 				return err
 			}
 		}
 	}
 
+	if v, ok := d.GetOk("mfa_settings"); ok {
+		if err := setCognitoUserMFAPreference(conn, d, v.([]interface{})); err != nil {
+			return err
+		}
+	}
+
 	if v, ok := d.GetOk("permanent_password"); ok {
 		log.Print("[DEBUG] Setting Cognito User permanent password")
 
@@ -247,31 +279,50 @@ func resourceAwsCognitoUserRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("user_attributes", userAttributes)
 	d.Set("enabled", resp.Enabled)
 	d.Set("user_status", resp.UserStatus)
+	d.Set("mfa_settings", flattenCognitoUserMFASettings(resp.UserMFASettingList, resp.PreferredMfaSetting))
 
-	groupsParams := &cognitoidentityprovider.AdminListGroupsForUserInput{
-		Username:   aws.String(d.Get("username").(string)),
-		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
-	}
-
-	groupsResp, err := conn.AdminListGroupsForUser(groupsParams)
+	groups, err := listCognitoUserGroups(conn, d)
 	if err != nil {
 		log.Print("[DEBUG] Could not get Cognito User groups", err)
 	} else {
-		groups := make([]string, len(groupsResp.Groups))
-		for _, v := range groupsResp.Groups {
-			groups = append(groups, *v.GroupName)
-		}
 		d.Set("groups", groups)
 	}
 
 	return nil
 }
 
+func listCognitoUserGroups(conn *cognitoidentityprovider.CognitoIdentityProvider, d *schema.ResourceData) ([]string, error) {
+	var groups []string
+
+	params := &cognitoidentityprovider.AdminListGroupsForUserInput{
+		Username:   aws.String(d.Get("username").(string)),
+		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+	}
+
+	for {
+		resp, err := conn.AdminListGroupsForUser(params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range resp.Groups {
+			groups = append(groups, aws.StringValue(v.GroupName))
+		}
+
+		if resp.NextToken == nil {
+			break
+		}
+		params.NextToken = resp.NextToken
+	}
+
+	return groups, nil
+}
+
 func resourceAwsCognitoUserUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cognitoidpconn
 
 	params := &cognitoidentityprovider.AdminUpdateUserAttributesInput{
-		Username:   aws.String(d.Get("name").(string)),
+		Username:   aws.String(d.Get("username").(string)),
 		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
 	}
 
@@ -281,32 +332,17 @@ func resourceAwsCognitoUserUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	if d.HasChange("groups") {
 		o, n := d.GetChange("groups")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
 
-		for _, v := range n.([]string) {
-			if contains(o.([]string), v) {
-				continue
-			}
-			log.Print("[DEBUG] Adding Cognito User to group", v)
-
-			groupParams := &cognitoidentityprovider.AdminAddUserToGroupInput{
-				Username:   aws.String(d.Get("username").(string)),
-				UserPoolId: aws.String(d.Get("user_pool_id").(string)),
-				GroupName:  aws.String(v),
-			}
-
-			_, err := conn.AdminAddUserToGroup(groupParams)
-			if err != nil {
-				return fmt.Errorf("Error adding Cognito User to group: %s", err)
+		for _, v := range ns.Difference(os).List() {
+			if err := addUserToGroup(conn, d, v.(string)); err != nil {
+				return err
 			}
-
-			log.Print("[DEBUG] Added Cognito User to group", v)
 		}
 
-		for _, v := range o.([]string) {
-			if contains(n.([]string), v) {
-				continue
-			}
-			if err := addUserToGroup(conn, d, v); err != nil {
+		for _, v := range os.Difference(ns).List() {
+			if err := removeUserFromGroup(conn, d, v.(string)); err != nil {
 				return err
 			}
 		}
@@ -319,6 +355,13 @@ func resourceAwsCognitoUserUpdate(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Error updating Cognito User: %s", err)
 	}
 
+	if d.HasChange("mfa_settings") {
+		v := d.Get("mfa_settings").([]interface{})
+		if err := setCognitoUserMFAPreference(conn, d, v); err != nil {
+			return err
+		}
+	}
+
 	return resourceAwsCognitoUserRead(d, meta)
 }
 
@@ -352,13 +395,60 @@ func resourceAwsCognitoUserImport(d *schema.ResourceData, meta interface{}) ([]*
 	return []*schema.ResourceData{d}, nil
 }
 
-func contains(s []string, e string) bool {
-	for _, a := range s {
-		if a == e {
-			return true
+func setCognitoUserMFAPreference(conn *cognitoidentityprovider.CognitoIdentityProvider, d *schema.ResourceData, mfaSettings []interface{}) error {
+	params := &cognitoidentityprovider.AdminSetUserMFAPreferenceInput{
+		Username:   aws.String(d.Get("username").(string)),
+		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+	}
+
+	if len(mfaSettings) > 0 && mfaSettings[0] != nil {
+		m := mfaSettings[0].(map[string]interface{})
+
+		params.SMSMfaSettings = &cognitoidentityprovider.SMSMfaSettingsType{
+			Enabled: aws.Bool(m["sms_mfa_enabled"].(bool)),
+		}
+
+		params.SoftwareTokenMfaSettings = &cognitoidentityprovider.SoftwareTokenMfaSettingsType{
+			Enabled: aws.Bool(m["software_token_mfa_enabled"].(bool)),
 		}
+
+		if v, ok := m["preferred_mfa"].(string); ok && v != "" {
+			params.SMSMfaSettings.PreferredMfa = aws.Bool(v == "SMS_MFA")
+			params.SoftwareTokenMfaSettings.PreferredMfa = aws.Bool(v == "SOFTWARE_TOKEN_MFA")
+		}
+	}
+
+	log.Print("[DEBUG] Setting Cognito User MFA preference")
+
+	_, err := conn.AdminSetUserMFAPreference(params)
+	if err != nil {
+		return fmt.Errorf("Error setting Cognito User MFA preference: %s", err)
 	}
-	return false
+
+	return nil
+}
+
+func flattenCognitoUserMFASettings(mfaSettingList []*string, preferredMfaSetting *string) []map[string]interface{} {
+	m := map[string]interface{}{
+		"sms_mfa_enabled":            false,
+		"software_token_mfa_enabled": false,
+		"preferred_mfa":              "",
+	}
+
+	for _, v := range mfaSettingList {
+		switch aws.StringValue(v) {
+		case "SMS_MFA":
+			m["sms_mfa_enabled"] = true
+		case "SOFTWARE_TOKEN_MFA":
+			m["software_token_mfa_enabled"] = true
+		}
+	}
+
+	if preferredMfaSetting != nil {
+		m["preferred_mfa"] = aws.StringValue(preferredMfaSetting)
+	}
+
+	return []map[string]interface{}{m}
 }
 
 func addUserToGroup(conn *cognitoidentityprovider.CognitoIdentityProvider, d *schema.ResourceData, g string) error {
@@ -379,3 +469,22 @@ func addUserToGroup(conn *cognitoidentityprovider.CognitoIdentityProvider, d *sc
 
 	return nil
 }
+
+func removeUserFromGroup(conn *cognitoidentityprovider.CognitoIdentityProvider, d *schema.ResourceData, g string) error {
+	log.Print("[DEBUG] Removing Cognito User from group", g)
+
+	groupParams := &cognitoidentityprovider.AdminRemoveUserFromGroupInput{
+		Username:   aws.String(d.Get("username").(string)),
+		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+		GroupName:  aws.String(g),
+	}
+
+	_, err := conn.AdminRemoveUserFromGroup(groupParams)
+	if err != nil {
+		return fmt.Errorf("Error removing Cognito User from group: %s", err)
+	}
+
+	log.Print("[DEBUG] Removed Cognito User from group", g)
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// resourceAwsCognitoUserInGroup manages a single user's membership in a
+// single Cognito group, independently of the lifecycle of either the user
+// or the group, mirroring the separation aws_iam_user_group_membership
+// provides for IAM.
+func resourceAwsCognitoUserInGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCognitoUserInGroupCreate,
+		Read:   resourceAwsCognitoUserInGroupRead,
+		Delete: resourceAwsCognitoUserInGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsCognitoUserInGroupImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"username": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCognitoUserInGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	userPoolId := d.Get("user_pool_id").(string)
+	groupName := d.Get("group_name").(string)
+	username := d.Get("username").(string)
+
+	log.Print("[DEBUG] Adding Cognito User to group")
+
+	_, err := conn.AdminAddUserToGroup(&cognitoidentityprovider.AdminAddUserToGroupInput{
+		UserPoolId: aws.String(userPoolId),
+		GroupName:  aws.String(groupName),
+		Username:   aws.String(username),
+	})
+	if err != nil {
+		return fmt.Errorf("Error adding Cognito User to group: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", userPoolId, groupName, username))
+
+	return resourceAwsCognitoUserInGroupRead(d, meta)
+}
+
+func resourceAwsCognitoUserInGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	groupName := d.Get("group_name").(string)
+
+	groups, err := listCognitoUserGroups(conn, d)
+	if err != nil {
+		if isAWSErr(err, "ResourceNotFoundException", "") {
+			log.Printf("[WARN] Cognito User %s is already gone", d.Get("username").(string))
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Cognito User groups: %s", err)
+	}
+
+	found := false
+	for _, g := range groups {
+		if g == groupName {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		log.Printf("[WARN] Cognito User %s is no longer in group %s", d.Get("username").(string), groupName)
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceAwsCognitoUserInGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	log.Print("[DEBUG] Removing Cognito User from group")
+
+	_, err := conn.AdminRemoveUserFromGroup(&cognitoidentityprovider.AdminRemoveUserFromGroupInput{
+		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+		GroupName:  aws.String(d.Get("group_name").(string)),
+		Username:   aws.String(d.Get("username").(string)),
+	})
+	if err != nil {
+		if isAWSErr(err, "ResourceNotFoundException", "") {
+			return nil
+		}
+		return fmt.Errorf("Error removing Cognito User from group: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCognitoUserInGroupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idSplit := strings.Split(d.Id(), "/")
+	if len(idSplit) != 3 {
+		return nil, errors.New("Error importing Cognito User In Group. Must specify user_pool_id/group_name/username")
+	}
+	d.Set("user_pool_id", idSplit[0])
+	d.Set("group_name", idSplit[1])
+	d.Set("username", idSplit[2])
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,138 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccAWSCognitoUserInGroup_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_cognito_user_in_group.main"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCognitoIdentityProvider(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserInGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserInGroupConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserInGroupExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCognitoUserInGroupExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+		resp, err := conn.AdminListGroupsForUser(&cognitoidentityprovider.AdminListGroupsForUserInput{
+			UserPoolId: aws.String(rs.Primary.Attributes["user_pool_id"]),
+			Username:   aws.String(rs.Primary.Attributes["username"]),
+		})
+		if err != nil {
+			return err
+		}
+
+		groupName := rs.Primary.Attributes["group_name"]
+		for _, g := range resp.Groups {
+			if aws.StringValue(g.GroupName) == groupName {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Cognito User %s is not in group %s", rs.Primary.Attributes["username"], groupName)
+	}
+}
+
+func testAccCheckAWSCognitoUserInGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cognito_user_in_group" {
+			continue
+		}
+
+		resp, err := conn.AdminListGroupsForUser(&cognitoidentityprovider.AdminListGroupsForUserInput{
+			UserPoolId: aws.String(rs.Primary.Attributes["user_pool_id"]),
+			Username:   aws.String(rs.Primary.Attributes["username"]),
+		})
+		if err != nil {
+			if isAWSErr(err, "ResourceNotFoundException", "") {
+				continue
+			}
+			return err
+		}
+
+		groupName := rs.Primary.Attributes["group_name"]
+		for _, g := range resp.Groups {
+			if aws.StringValue(g.GroupName) == groupName {
+				return fmt.Errorf("Cognito User %s is still in group %s", rs.Primary.Attributes["username"], groupName)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSCognitoUserInGroupConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "main" {
+  name = "%[1]s"
+}
+
+resource "aws_iam_role" "group_role" {
+  name = "%[1]s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Federated": "cognito-identity.amazonaws.com"
+      },
+      "Action": "sts:AssumeRoleWithWebIdentity"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_cognito_user_group" "main" {
+  name         = "%[1]s"
+  user_pool_id = "${aws_cognito_user_pool.main.id}"
+  role_arn     = "${aws_iam_role.group_role.arn}"
+}
+
+resource "aws_cognito_user" "main" {
+  username     = "%[1]s"
+  user_pool_id = "${aws_cognito_user_pool.main.id}"
+}
+
+resource "aws_cognito_user_in_group" "main" {
+  user_pool_id = "${aws_cognito_user_pool.main.id}"
+  group_name   = "${aws_cognito_user_group.main.name}"
+  username     = "${aws_cognito_user.main.username}"
+}
+`, rName)
+}
@@ -0,0 +1,222 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// resourceAwsCognitoUserPoolMigrationSource wires a legacy user store into
+// an aws_cognito_user_pool's MigrateUser Lambda trigger, so existing users
+// are transparently migrated into Cognito on first sign-in or
+// forgot-password instead of requiring a bulk import up front.
+//
+// The pool's LambdaConfig has no per-trigger API, only a wholesale
+// UpdateUserPool, so this resource reads the pool's current LambdaConfig
+// and writes it back with only UserMigration changed rather than
+// overwriting the other configured triggers (see
+// setCognitoUserPoolMigrationLambda).
+func resourceAwsCognitoUserPoolMigrationSource() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCognitoUserPoolMigrationSourceCreate,
+		Read:   resourceAwsCognitoUserPoolMigrationSourceRead,
+		Delete: resourceAwsCognitoUserPoolMigrationSourceDelete,
+
+		Schema: map[string]*schema.Schema{
+			"user_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"dynamodb_table_arn": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"lambda_arn"},
+			},
+			"lambda_arn": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"dynamodb_table_arn"},
+			},
+			"lambda_execution_role_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"create_function": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"function_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCognitoUserPoolMigrationSourceCreate(d *schema.ResourceData, meta interface{}) error {
+	cidpconn := meta.(*AWSClient).cognitoidpconn
+
+	userPoolId := d.Get("user_pool_id").(string)
+	createFunction := d.Get("create_function").(bool)
+
+	var functionArn string
+
+	if v, ok := d.GetOk("lambda_arn"); ok {
+		functionArn = v.(string)
+	} else if createFunction {
+		createdArn, err := createCognitoUserMigrationFunction(meta, userPoolId, d)
+		if err != nil {
+			return err
+		}
+		functionArn = createdArn
+	} else {
+		return fmt.Errorf("lambda_arn is required when create_function is false")
+	}
+
+	log.Print("[DEBUG] Wiring Cognito User Pool MigrateUser trigger")
+
+	if err := setCognitoUserPoolMigrationLambda(cidpconn, userPoolId, aws.String(functionArn)); err != nil {
+		return fmt.Errorf("Error wiring Cognito User Pool MigrateUser trigger: %s", err)
+	}
+
+	d.Set("function_arn", functionArn)
+	d.SetId(fmt.Sprintf("%s/migration-source", userPoolId))
+
+	return resourceAwsCognitoUserPoolMigrationSourceRead(d, meta)
+}
+
+// setCognitoUserPoolMigrationLambda sets or clears the pool's UserMigration
+// trigger without disturbing any other Lambda triggers already configured
+// on it, since UpdateUserPool replaces the entire LambdaConfig wholesale.
+func setCognitoUserPoolMigrationLambda(conn *cognitoidentityprovider.CognitoIdentityProvider, userPoolId string, userMigrationArn *string) error {
+	resp, err := conn.DescribeUserPool(&cognitoidentityprovider.DescribeUserPoolInput{
+		UserPoolId: aws.String(userPoolId),
+	})
+	if err != nil {
+		// Returned unwrapped so callers (e.g. Delete) can still match it with
+		// isAWSErr against the pool having already been destroyed.
+		return err
+	}
+
+	lambdaConfig := &cognitoidentityprovider.LambdaConfigType{}
+	if resp.UserPool.LambdaConfig != nil {
+		*lambdaConfig = *resp.UserPool.LambdaConfig
+	}
+	lambdaConfig.UserMigration = userMigrationArn
+
+	_, err = conn.UpdateUserPool(&cognitoidentityprovider.UpdateUserPoolInput{
+		UserPoolId:   aws.String(userPoolId),
+		LambdaConfig: lambdaConfig,
+	})
+
+	return err
+}
+
+func resourceAwsCognitoUserPoolMigrationSourceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	resp, err := conn.DescribeUserPool(&cognitoidentityprovider.DescribeUserPoolInput{
+		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+	})
+	if err != nil {
+		if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Cognito User Pool %s is already gone", d.Get("user_pool_id").(string))
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Cognito User Pool: %s", err)
+	}
+
+	if resp.UserPool.LambdaConfig == nil || aws.StringValue(resp.UserPool.LambdaConfig.UserMigration) == "" {
+		log.Print("[WARN] Cognito User Pool MigrateUser trigger is no longer configured")
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("function_arn", resp.UserPool.LambdaConfig.UserMigration)
+
+	return nil
+}
+
+func resourceAwsCognitoUserPoolMigrationSourceDelete(d *schema.ResourceData, meta interface{}) error {
+	cidpconn := meta.(*AWSClient).cognitoidpconn
+
+	log.Print("[DEBUG] Removing Cognito User Pool MigrateUser trigger")
+
+	err := setCognitoUserPoolMigrationLambda(cidpconn, d.Get("user_pool_id").(string), nil)
+	if err != nil && !isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+		return fmt.Errorf("Error removing Cognito User Pool MigrateUser trigger: %s", err)
+	}
+
+	if d.Get("create_function").(bool) {
+		lambdaconn := meta.(*AWSClient).lambdaconn
+		functionName := cognitoUserMigrationFunctionName(d.Get("user_pool_id").(string))
+
+		_, err := lambdaconn.DeleteFunction(&lambda.DeleteFunctionInput{
+			FunctionName: aws.String(functionName),
+		})
+		if err != nil && !isAWSErr(err, lambda.ErrCodeResourceNotFoundException, "") {
+			return fmt.Errorf("Error deleting canned Cognito User migration Lambda function: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func cognitoUserMigrationFunctionName(userPoolId string) string {
+	return fmt.Sprintf("%s-user-migration", userPoolId)
+}
+
+// createCognitoUserMigrationFunction deploys the canned MigrateUser trigger
+// (see cognito_user_migration_lambda/main.go) pointed at the given DynamoDB
+// table, and returns its ARN.
+func createCognitoUserMigrationFunction(meta interface{}, userPoolId string, d *schema.ResourceData) (string, error) {
+	tableArn, ok := d.GetOk("dynamodb_table_arn")
+	if !ok {
+		return "", fmt.Errorf("one of lambda_arn or dynamodb_table_arn must be set")
+	}
+
+	roleArn, ok := d.GetOk("lambda_execution_role_arn")
+	if !ok {
+		return "", fmt.Errorf("lambda_execution_role_arn is required when dynamodb_table_arn is set")
+	}
+
+	zip, err := cognitoUserMigrationLambdaZip()
+	if err != nil {
+		return "", fmt.Errorf("Error reading canned Cognito User migration Lambda package: %s", err)
+	}
+
+	lambdaconn := meta.(*AWSClient).lambdaconn
+
+	log.Print("[DEBUG] Creating canned Cognito User migration Lambda function")
+
+	resp, err := lambdaconn.CreateFunction(&lambda.CreateFunctionInput{
+		FunctionName: aws.String(cognitoUserMigrationFunctionName(userPoolId)),
+		Runtime:      aws.String(lambda.RuntimeGo1X),
+		Handler:      aws.String("main"),
+		Role:         aws.String(roleArn.(string)),
+		Code: &lambda.FunctionCode{
+			ZipFile: zip,
+		},
+		Environment: &lambda.Environment{
+			Variables: map[string]*string{
+				"MIGRATION_DYNAMODB_TABLE_ARN": aws.String(tableArn.(string)),
+			},
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("Error creating canned Cognito User migration Lambda function: %s", err)
+	}
+
+	return aws.StringValue(resp.FunctionArn), nil
+}
@@ -0,0 +1,192 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+// TestAccAWSCognitoUserPoolMigrationSource_dynamodb seeds a legacy user
+// record into a DynamoDB table, wires it up as a migration source, signs in
+// with the legacy username, and confirms Cognito materializes the user via
+// the canned MigrateUser trigger.
+func TestAccAWSCognitoUserPoolMigrationSource_dynamodb(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc")
+	legacyUsername := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	legacyEmail := fmt.Sprintf("%s@example.com", legacyUsername)
+	resourceName := "aws_cognito_user_pool_migration_source.main"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCognitoIdentityProvider(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolMigrationSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserPoolMigrationSourceConfig_dynamodb(rName, legacyUsername, legacyEmail),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "function_arn"),
+					testAccCheckAWSCognitoUserMigrated(rName, legacyUsername),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCognitoUserMigrated(userPoolResourceName, username string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		poolRs, ok := s.RootModule().Resources["aws_cognito_user_pool.main"]
+		if !ok {
+			return fmt.Errorf("Not found: aws_cognito_user_pool.main")
+		}
+
+		clientRs, ok := s.RootModule().Resources["aws_cognito_user_pool_client.main"]
+		if !ok {
+			return fmt.Errorf("Not found: aws_cognito_user_pool_client.main")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+		// Signing in as the (not yet migrated) legacy username fires the
+		// MigrateUser trigger, which materializes the user from the seeded
+		// DynamoDB record.
+		_, err := conn.InitiateAuth(&cognitoidentityprovider.InitiateAuthInput{
+			AuthFlow: aws.String("USER_PASSWORD_AUTH"),
+			ClientId: aws.String(clientRs.Primary.ID),
+			AuthParameters: map[string]*string{
+				"USERNAME": aws.String(username),
+				"PASSWORD": aws.String("tf-acc-legacy-Passw0rd!1"),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("Error signing in as legacy user %s: %s", username, err)
+		}
+
+		_, err = conn.AdminGetUser(&cognitoidentityprovider.AdminGetUserInput{
+			UserPoolId: aws.String(poolRs.Primary.ID),
+			Username:   aws.String(username),
+		})
+		return err
+	}
+}
+
+func testAccCheckAWSCognitoUserPoolMigrationSourceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cognito_user_pool_migration_source" {
+			continue
+		}
+
+		resp, err := conn.DescribeUserPool(&cognitoidentityprovider.DescribeUserPoolInput{
+			UserPoolId: aws.String(rs.Primary.Attributes["user_pool_id"]),
+		})
+
+		if err != nil {
+			if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+				return nil
+			}
+			return err
+		}
+
+		if resp.UserPool.LambdaConfig != nil && aws.StringValue(resp.UserPool.LambdaConfig.UserMigration) != "" {
+			return fmt.Errorf("Cognito User Pool MigrateUser trigger still configured: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSCognitoUserPoolMigrationSourceConfig_dynamodb(rName, legacyUsername, legacyEmail string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "legacy_users" {
+  name         = "%[1]s"
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "username"
+
+  attribute {
+    name = "username"
+    type = "S"
+  }
+}
+
+resource "aws_dynamodb_table_item" "legacy_user" {
+  table_name = "${aws_dynamodb_table.legacy_users.name}"
+  hash_key   = "${aws_dynamodb_table.legacy_users.hash_key}"
+
+  item = <<ITEM
+{
+  "username": {"S": "%[2]s"},
+  "email": {"S": "%[3]s"}
+}
+ITEM
+}
+
+resource "aws_iam_role" "migration_lambda" {
+  name = "%[1]s-migration"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "migration_lambda" {
+  name = "%[1]s-dynamodb"
+  role = "${aws_iam_role.migration_lambda.id}"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "dynamodb:GetItem",
+        "dynamodb:Query"
+      ],
+      "Resource": [
+        "${aws_dynamodb_table.legacy_users.arn}",
+        "${aws_dynamodb_table.legacy_users.arn}/index/*"
+      ]
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_cognito_user_pool" "main" {
+  name = "%[1]s"
+}
+
+resource "aws_cognito_user_pool_client" "main" {
+  name                = "%[1]s"
+  user_pool_id        = "${aws_cognito_user_pool.main.id}"
+  generate_secret     = false
+  explicit_auth_flows = ["ALLOW_USER_PASSWORD_AUTH", "ALLOW_REFRESH_TOKEN_AUTH"]
+}
+
+resource "aws_cognito_user_pool_migration_source" "main" {
+  user_pool_id              = "${aws_cognito_user_pool.main.id}"
+  dynamodb_table_arn        = "${aws_dynamodb_table.legacy_users.arn}"
+  lambda_execution_role_arn = "${aws_iam_role.migration_lambda.arn}"
+  create_function           = true
+
+  depends_on = ["aws_dynamodb_table_item.legacy_user"]
+}
+`, rName, legacyUsername, legacyEmail)
+}
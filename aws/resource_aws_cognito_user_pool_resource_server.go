@@ -0,0 +1,218 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceAwsCognitoUserPoolResourceServer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCognitoUserPoolResourceServerCreate,
+		Read:   resourceAwsCognitoUserPoolResourceServerRead,
+		Update: resourceAwsCognitoUserPoolResourceServerUpdate,
+		Delete: resourceAwsCognitoUserPoolResourceServerDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsCognitoUserPoolResourceServerImport,
+		},
+
+		// https://docs.aws.amazon.com/cognito-user-identity-pools/latest/APIReference/API_CreateResourceServer.html
+		Schema: map[string]*schema.Schema{
+			"identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"user_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"scopes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"scope_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+						"scope_description": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+					},
+				},
+			},
+			"scope_identifiers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAwsCognitoUserPoolResourceServerCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	identifier := d.Get("identifier").(string)
+	userPoolId := d.Get("user_pool_id").(string)
+
+	params := &cognitoidentityprovider.CreateResourceServerInput{
+		Identifier: aws.String(identifier),
+		Name:       aws.String(d.Get("name").(string)),
+		UserPoolId: aws.String(userPoolId),
+	}
+
+	if v, ok := d.GetOk("scopes"); ok {
+		params.Scopes = expandCognitoUserPoolResourceServerScopes(v.(*schema.Set).List())
+	}
+
+	log.Print("[DEBUG] Creating Cognito Resource Server")
+
+	_, err := conn.CreateResourceServer(params)
+	if err != nil {
+		return fmt.Errorf("Error creating Cognito Resource Server: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", userPoolId, identifier))
+
+	return resourceAwsCognitoUserPoolResourceServerRead(d, meta)
+}
+
+func resourceAwsCognitoUserPoolResourceServerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	params := &cognitoidentityprovider.DescribeResourceServerInput{
+		Identifier: aws.String(d.Get("identifier").(string)),
+		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+	}
+
+	log.Print("[DEBUG] Reading Cognito Resource Server")
+
+	resp, err := conn.DescribeResourceServer(params)
+	if err != nil {
+		if isAWSErr(err, "ResourceNotFoundException", "") {
+			log.Printf("[WARN] Cognito Resource Server %s is already gone", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Cognito Resource Server: %s", err)
+	}
+
+	d.Set("identifier", resp.ResourceServer.Identifier)
+	d.Set("name", resp.ResourceServer.Name)
+	d.Set("user_pool_id", resp.ResourceServer.UserPoolId)
+	d.Set("scopes", flattenCognitoUserPoolResourceServerScopes(resp.ResourceServer.Scopes))
+	d.Set("scope_identifiers", flattenCognitoUserPoolResourceServerScopeIdentifiers(aws.StringValue(resp.ResourceServer.Identifier), resp.ResourceServer.Scopes))
+
+	return nil
+}
+
+func resourceAwsCognitoUserPoolResourceServerUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	// UpdateResourceServer is a full-replace call, so scopes must be resent
+	// unconditionally like name is just above: guarding it on HasChange would
+	// clear AWS's configured scopes on any update that didn't touch scopes.
+	params := &cognitoidentityprovider.UpdateResourceServerInput{
+		Identifier: aws.String(d.Get("identifier").(string)),
+		Name:       aws.String(d.Get("name").(string)),
+		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+		Scopes:     expandCognitoUserPoolResourceServerScopes(d.Get("scopes").(*schema.Set).List()),
+	}
+
+	log.Print("[DEBUG] Updating Cognito Resource Server")
+
+	_, err := conn.UpdateResourceServer(params)
+	if err != nil {
+		return fmt.Errorf("Error updating Cognito Resource Server: %s", err)
+	}
+
+	return resourceAwsCognitoUserPoolResourceServerRead(d, meta)
+}
+
+func resourceAwsCognitoUserPoolResourceServerDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	params := &cognitoidentityprovider.DeleteResourceServerInput{
+		Identifier: aws.String(d.Get("identifier").(string)),
+		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+	}
+
+	log.Print("[DEBUG] Deleting Cognito Resource Server")
+
+	_, err := conn.DeleteResourceServer(params)
+	if err != nil {
+		if isAWSErr(err, "ResourceNotFoundException", "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Cognito Resource Server: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCognitoUserPoolResourceServerImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idSplit := strings.Split(d.Id(), "/")
+	if len(idSplit) != 2 {
+		return nil, errors.New("Error importing Cognito Resource Server. Must specify user_pool_id/identifier")
+	}
+	userPoolId := idSplit[0]
+	identifier := idSplit[1]
+	d.Set("user_pool_id", userPoolId)
+	d.Set("identifier", identifier)
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandCognitoUserPoolResourceServerScopes(in []interface{}) []*cognitoidentityprovider.ResourceServerScopeType {
+	scopes := make([]*cognitoidentityprovider.ResourceServerScopeType, len(in))
+
+	for i, v := range in {
+		m := v.(map[string]interface{})
+		scopes[i] = &cognitoidentityprovider.ResourceServerScopeType{
+			ScopeName:        aws.String(m["scope_name"].(string)),
+			ScopeDescription: aws.String(m["scope_description"].(string)),
+		}
+	}
+
+	return scopes
+}
+
+func flattenCognitoUserPoolResourceServerScopes(in []*cognitoidentityprovider.ResourceServerScopeType) []map[string]interface{} {
+	scopes := make([]map[string]interface{}, len(in))
+
+	for i, v := range in {
+		scopes[i] = map[string]interface{}{
+			"scope_name":        aws.StringValue(v.ScopeName),
+			"scope_description": aws.StringValue(v.ScopeDescription),
+		}
+	}
+
+	return scopes
+}
+
+func flattenCognitoUserPoolResourceServerScopeIdentifiers(identifier string, in []*cognitoidentityprovider.ResourceServerScopeType) []string {
+	identifiers := make([]string, len(in))
+
+	for i, v := range in {
+		identifiers[i] = fmt.Sprintf("%s/%s", identifier, aws.StringValue(v.ScopeName))
+	}
+
+	return identifiers
+}
@@ -0,0 +1,126 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceAwsCognitoUserSoftwareToken() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCognitoUserSoftwareTokenCreate,
+		Read:   resourceAwsCognitoUserSoftwareTokenRead,
+		Delete: resourceAwsCognitoUserSoftwareTokenDelete,
+
+		// https://docs.aws.amazon.com/cognito-user-identity-pools/latest/APIReference/API_AssociateSoftwareToken.html
+		Schema: map[string]*schema.Schema{
+			"access_token": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"session"},
+			},
+			"session": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"access_token"},
+			},
+			"user_code": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(6, 6),
+			},
+			"friendly_device_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"secret_code": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCognitoUserSoftwareTokenCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	params := &cognitoidentityprovider.AssociateSoftwareTokenInput{}
+
+	if v, ok := d.GetOk("access_token"); ok {
+		params.AccessToken = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("session"); ok {
+		params.Session = aws.String(v.(string))
+	}
+
+	log.Print("[DEBUG] Associating Cognito User software token")
+
+	resp, err := conn.AssociateSoftwareToken(params)
+	if err != nil {
+		return fmt.Errorf("Error associating Cognito User software token: %s", err)
+	}
+
+	d.Set("secret_code", resp.SecretCode)
+
+	if v, ok := d.GetOk("user_code"); ok {
+		verifyParams := &cognitoidentityprovider.VerifySoftwareTokenInput{
+			UserCode: aws.String(v.(string)),
+		}
+
+		if v, ok := d.GetOk("access_token"); ok {
+			verifyParams.AccessToken = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("session"); ok {
+			verifyParams.Session = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("friendly_device_name"); ok {
+			verifyParams.FriendlyDeviceName = aws.String(v.(string))
+		}
+
+		log.Print("[DEBUG] Verifying Cognito User software token")
+
+		verifyResp, err := conn.VerifySoftwareToken(verifyParams)
+		if err != nil {
+			return fmt.Errorf("Error verifying Cognito User software token: %s", err)
+		}
+
+		d.Set("status", verifyResp.Status)
+	}
+
+	// The TOTP secret itself must never become the resource ID: Terraform
+	// core prints IDs in plain text in plan/apply output regardless of
+	// Sensitive, which would leak it straight into CI logs.
+	d.SetId(resource.UniqueId())
+
+	return resourceAwsCognitoUserSoftwareTokenRead(d, meta)
+}
+
+func resourceAwsCognitoUserSoftwareTokenRead(d *schema.ResourceData, meta interface{}) error {
+	// AssociateSoftwareToken/VerifySoftwareToken have no corresponding
+	// describe API, so the resource's state is fixed at creation time.
+	return nil
+}
+
+func resourceAwsCognitoUserSoftwareTokenDelete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,43 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+// AssociateSoftwareToken/VerifySoftwareToken operate against an
+// authenticated user session rather than anything the provider can
+// bootstrap on its own, so this test relies on an access token for an
+// existing Cognito user being supplied out of band.
+func TestAccAWSCognitoUserSoftwareToken_basic(t *testing.T) {
+	accessToken := os.Getenv("TF_ACC_COGNITO_ACCESS_TOKEN")
+	if accessToken == "" {
+		t.Skip("TF_ACC_COGNITO_ACCESS_TOKEN must be set to an authenticated Cognito User access token to run this test")
+	}
+
+	resourceName := "aws_cognito_user_software_token.main"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckAWSCognitoIdentityProvider(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserSoftwareTokenConfig_basic(accessToken),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "secret_code"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSCognitoUserSoftwareTokenConfig_basic(accessToken string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_software_token" "main" {
+  access_token = %[1]q
+}
+`, accessToken)
+}